@@ -0,0 +1,98 @@
+// Package chunker splits an extracted package into retrieval chunks under
+// a configurable granularity policy, the single biggest lever on RAG
+// quality for a symbol-oriented corpus.
+package chunker
+
+import (
+	"fmt"
+
+	"github.com/mdrideout/viberag/extractor"
+	"github.com/mdrideout/viberag/render"
+)
+
+// ID identifies a Chunk within a single Chunks call's output.
+type ID string
+
+// Kind categorizes what a Chunk contains.
+type Kind string
+
+const (
+	KindSymbol  Kind = "symbol"
+	KindType    Kind = "type"
+	KindFile    Kind = "file"
+	KindExample Kind = "example"
+)
+
+// Chunk is a single retrieval unit produced from a Package.
+type Chunk struct {
+	ID   ID
+	Kind Kind
+
+	// SymbolPath identifies what the chunk documents, e.g. "sample.Greeter"
+	// or "sample.Greeter.Greet".
+	SymbolPath string
+
+	Text          string
+	TokenEstimate int
+
+	// Neighbors are sibling chunks (same type, file, or parent symbol) a
+	// retriever can use for small-window context expansion.
+	Neighbors []ID
+}
+
+// Policy controls chunk granularity.
+type Policy int
+
+const (
+	// ChunkPerSymbol emits one chunk per func, type, method, const, or var.
+	// It is the default: the finest granularity, best for precise retrieval.
+	ChunkPerSymbol Policy = iota
+	// ChunkPerType collapses a type with its constructors and methods into
+	// one chunk, so "how do I use Greeter?" retrieves everything at once.
+	ChunkPerType
+	// ChunkPerFile emits one chunk per source file.
+	ChunkPerFile
+)
+
+// Options configures Chunks.
+type Options struct {
+	Policy Policy
+
+	// Estimator sizes chunk text in tokens. Defaults to ApproxEstimator.
+	Estimator TokenEstimator
+	// MaxTokens caps a single chunk's size; chunks over this are split at
+	// doc-comment paragraph boundaries. Zero means unbounded.
+	MaxTokens int
+
+	// Repo links each chunk's source code block back to its origin, as in
+	// render.Render.
+	Repo render.RepositoryInfo
+}
+
+// Chunks splits pkg into chunks under opts.Policy.
+func Chunks(pkg *extractor.Package, opts Options) ([]Chunk, error) {
+	if pkg == nil {
+		return nil, fmt.Errorf("chunker: nil package")
+	}
+	if opts.Estimator == nil {
+		opts.Estimator = ApproxEstimator{}
+	}
+
+	switch opts.Policy {
+	case ChunkPerSymbol:
+		return chunkPerSymbol(pkg, opts)
+	case ChunkPerType:
+		return chunkPerType(pkg, opts)
+	case ChunkPerFile:
+		return chunkPerFile(pkg, opts)
+	default:
+		return nil, fmt.Errorf("chunker: unknown policy %d", opts.Policy)
+	}
+}
+
+// groupingFor indexes pkg's methods and constructors by owning type, as
+// render.Render itself does, so chunker nests the same members Render
+// would under a type.
+func groupingFor(pkg *extractor.Package) *render.Grouping {
+	return render.NewGrouping(pkg)
+}
@@ -0,0 +1,155 @@
+package chunker_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mdrideout/viberag/chunker"
+	"github.com/mdrideout/viberag/extractor"
+)
+
+func TestChunkPerSymbol(t *testing.T) {
+	pkg, err := extractor.Extract("../test-fixtures/codebase")
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+
+	chunks, err := chunker.Chunks(pkg, chunker.Options{Policy: chunker.ChunkPerSymbol})
+	if err != nil {
+		t.Fatalf("Chunks: %v", err)
+	}
+
+	byPath := map[string][]chunker.Chunk{}
+	for _, c := range chunks {
+		byPath[c.SymbolPath] = append(byPath[c.SymbolPath], c)
+	}
+
+	var greeterType *chunker.Chunk
+	for i, c := range byPath["sample.Greeter"] {
+		if c.Kind == chunker.KindType {
+			greeterType = &byPath["sample.Greeter"][i]
+		}
+	}
+	if greeterType == nil {
+		t.Fatalf("no type chunk for sample.Greeter among %+v", byPath["sample.Greeter"])
+	}
+	wantNeighbors := map[string]bool{"sample.NewGreeter": true, "sample.Greeter.Greet": true}
+	for _, n := range greeterType.Neighbors {
+		delete(wantNeighbors, string(n))
+	}
+	if len(wantNeighbors) != 0 {
+		t.Errorf("sample.Greeter missing neighbors %v, got %v", wantNeighbors, greeterType.Neighbors)
+	}
+
+	var exampleChunks int
+	for _, c := range chunks {
+		if c.Kind == chunker.KindExample {
+			exampleChunks++
+		}
+	}
+	if exampleChunks != 4 {
+		t.Errorf("example chunks = %d, want 4", exampleChunks)
+	}
+}
+
+func TestChunkPerType(t *testing.T) {
+	pkg, err := extractor.Extract("../test-fixtures/codebase")
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+
+	chunks, err := chunker.Chunks(pkg, chunker.Options{Policy: chunker.ChunkPerType})
+	if err != nil {
+		t.Fatalf("Chunks: %v", err)
+	}
+
+	for _, c := range chunks {
+		if c.SymbolPath != "sample.Greeter" {
+			continue
+		}
+		if c.Kind != chunker.KindType {
+			t.Fatalf("Kind = %v, want KindType", c.Kind)
+		}
+		for _, want := range []string{"## type Greeter", "### func NewGreeter", "#### func (g *Greeter) Greet"} {
+			if !strings.Contains(c.Text, want) {
+				t.Errorf("collapsed chunk missing %q\n---\n%s", want, c.Text)
+			}
+		}
+		return
+	}
+	t.Fatal("no chunk found for sample.Greeter")
+}
+
+func TestChunkPerFile(t *testing.T) {
+	pkg, err := extractor.Extract("../test-fixtures/codebase")
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+
+	chunks, err := chunker.Chunks(pkg, chunker.Options{Policy: chunker.ChunkPerFile})
+	if err != nil {
+		t.Fatalf("Chunks: %v", err)
+	}
+
+	var fileChunks int
+	for _, c := range chunks {
+		if c.Kind == chunker.KindFile {
+			fileChunks++
+			if !strings.Contains(c.Text, "## type Greeter") || !strings.Contains(c.Text, "## func Add") {
+				t.Errorf("file chunk missing expected symbols\n---\n%s", c.Text)
+			}
+		}
+	}
+	if fileChunks != 1 {
+		t.Errorf("file chunks = %d, want 1", fileChunks)
+	}
+}
+
+func TestMaxTokensSplitsAtParagraphBoundaries(t *testing.T) {
+	dir := t.TempDir()
+	src := `package dep
+
+// Widget does something useful.
+//
+// This is a second paragraph with more detail about Widget's behavior and
+// why you might want to use it in your own code.
+func Widget() {}
+`
+	if err := os.WriteFile(filepath.Join(dir, "dep.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	pkg, err := extractor.Extract(dir)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+
+	chunks, err := chunker.Chunks(pkg, chunker.Options{Policy: chunker.ChunkPerSymbol, MaxTokens: 20})
+	if err != nil {
+		t.Fatalf("Chunks: %v", err)
+	}
+	if len(chunks) != 2 {
+		t.Fatalf("got %d chunks, want 2 (one per paragraph)", len(chunks))
+	}
+	if !strings.Contains(chunks[0].Text, "Widget does something useful") {
+		t.Errorf("first chunk missing first paragraph: %q", chunks[0].Text)
+	}
+	if strings.Contains(chunks[0].Text, "second paragraph") {
+		t.Errorf("first chunk should not contain second paragraph: %q", chunks[0].Text)
+	}
+	if !strings.Contains(chunks[1].Text, "second paragraph") {
+		t.Errorf("second chunk missing second paragraph: %q", chunks[1].Text)
+	}
+}
+
+func TestApproxEstimator(t *testing.T) {
+	var e chunker.ApproxEstimator
+	if got := e.Estimate(""); got != 0 {
+		t.Errorf("Estimate(\"\") = %d, want 0", got)
+	}
+	if got := e.Estimate("abcd"); got != 1 {
+		t.Errorf("Estimate(4 bytes) = %d, want 1", got)
+	}
+}
@@ -0,0 +1,24 @@
+package chunker
+
+// TokenEstimator sizes chunk text in (approximate) tokens, so callers can
+// cap chunk size without depending on a specific tokenizer.
+type TokenEstimator interface {
+	Estimate(text string) int
+}
+
+// ApproxEstimator estimates one token per four bytes, a common rule of
+// thumb for BPE tokenizers (such as tiktoken) over English and code text.
+// It's the zero-dependency fallback used when no tokenizer-specific
+// Estimator is supplied.
+type ApproxEstimator struct{}
+
+func (ApproxEstimator) Estimate(text string) int {
+	if len(text) == 0 {
+		return 0
+	}
+	n := len(text) / 4
+	if n == 0 {
+		n = 1
+	}
+	return n
+}
@@ -0,0 +1,53 @@
+package chunker
+
+import (
+	"strings"
+
+	"github.com/mdrideout/viberag/extractor"
+	"github.com/mdrideout/viberag/render"
+)
+
+// chunkPerFile emits one chunk per source file, containing every symbol
+// declared there, falling back to one chunk per symbol (as chunkPerSymbol
+// would) when the whole file's text doesn't fit opts.MaxTokens.
+func chunkPerFile(pkg *extractor.Package, opts Options) ([]Chunk, error) {
+	byFile := map[string][]*extractor.Symbol{}
+	var fileOrder []string
+	for _, sym := range pkg.Symbols {
+		if _, ok := byFile[sym.File]; !ok {
+			fileOrder = append(fileOrder, sym.File)
+		}
+		byFile[sym.File] = append(byFile[sym.File], sym)
+	}
+
+	var all []Chunk
+	for _, file := range fileOrder {
+		members := byFile[file]
+		path := pkg.Name + ":" + members[0].RelFile(pkg.Dir)
+
+		var parts []string
+		for _, m := range members {
+			parts = append(parts, render.RenderSymbol(pkg, m, opts.Repo))
+		}
+		full := strings.Join(parts, "")
+
+		var chunks []Chunk
+		if opts.MaxTokens <= 0 || opts.Estimator.Estimate(full) <= opts.MaxTokens {
+			chunks = []Chunk{newChunk(opts, ID(path), KindFile, path, full)}
+			for _, m := range members {
+				chunks = append(chunks, exampleChunks(pkg, opts, m)...)
+			}
+			linkMesh(chunks)
+		} else {
+			units := make([]symbolUnit, len(members))
+			for i, m := range members {
+				units[i] = buildSymbolUnit(pkg, opts, m)
+			}
+			chunks = linkUnits(units)
+		}
+
+		all = append(all, chunks...)
+	}
+
+	return all, nil
+}
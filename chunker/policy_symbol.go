@@ -0,0 +1,28 @@
+package chunker
+
+import "github.com/mdrideout/viberag/extractor"
+
+// chunkPerSymbol emits one chunk per func, type, method, const, and var,
+// with each type's constructors and methods linked to it and each other,
+// and loose (non-method, non-constructor) symbols linked to the others
+// declared in the same file.
+func chunkPerSymbol(pkg *extractor.Package, opts Options) ([]Chunk, error) {
+	g := groupingFor(pkg)
+
+	var all []Chunk
+	for _, sym := range pkg.Symbols {
+		if sym.Kind != extractor.KindType {
+			continue
+		}
+
+		members := typeMembers(sym, g)
+		units := make([]symbolUnit, len(members))
+		for i, m := range members {
+			units[i] = buildSymbolUnit(pkg, opts, m)
+		}
+		all = append(all, linkUnits(units)...)
+	}
+
+	all = append(all, chunkLooseSymbols(pkg, opts, g.LooseSymbols())...)
+	return all, nil
+}
@@ -0,0 +1,72 @@
+package chunker
+
+import (
+	"strings"
+
+	"github.com/mdrideout/viberag/extractor"
+	"github.com/mdrideout/viberag/render"
+)
+
+// chunkPerType collapses each type with its constructors and methods into
+// one chunk, falling back to one chunk per member (as chunkPerSymbol would)
+// when the collapsed text doesn't fit opts.MaxTokens.
+func chunkPerType(pkg *extractor.Package, opts Options) ([]Chunk, error) {
+	g := groupingFor(pkg)
+
+	var all []Chunk
+	for _, sym := range pkg.Symbols {
+		if sym.Kind != extractor.KindType {
+			continue
+		}
+
+		members := typeMembers(sym, g)
+		path := pkg.Name + "." + sym.Name
+
+		var parts []string
+		for _, m := range members {
+			parts = append(parts, renderMember(pkg, m, opts.Repo))
+		}
+		full := strings.Join(parts, "")
+
+		var chunks []Chunk
+		if opts.MaxTokens <= 0 || opts.Estimator.Estimate(full) <= opts.MaxTokens {
+			chunks = []Chunk{newChunk(opts, ID(path), KindType, path, full)}
+			for _, m := range members {
+				chunks = append(chunks, exampleChunks(pkg, opts, m)...)
+			}
+			linkMesh(chunks)
+		} else {
+			units := make([]symbolUnit, len(members))
+			for i, m := range members {
+				units[i] = buildSymbolUnit(pkg, opts, m)
+			}
+			chunks = linkUnits(units)
+		}
+
+		all = append(all, chunks...)
+	}
+
+	all = append(all, chunkLooseSymbols(pkg, opts, g.LooseSymbols())...)
+	return all, nil
+}
+
+// renderMember renders member at the same heading level Render would use
+// when nesting it under its owning type: "##" for the type itself, "###"
+// for a constructor, "####" for a method.
+func renderMember(pkg *extractor.Package, member *extractor.Symbol, repo render.RepositoryInfo) string {
+	level := "###"
+	switch member.Kind {
+	case extractor.KindType:
+		level = "##"
+	case extractor.KindMethod:
+		level = "####"
+	}
+
+	var b strings.Builder
+	b.WriteString(render.SymbolHeader(pkg, member, level, repo))
+	if member.Doc != "" {
+		b.WriteString(render.DocMarkdown(pkg, pkg.ParseDoc(member)))
+		b.WriteString("\n\n")
+	}
+	return b.String()
+}
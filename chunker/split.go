@@ -0,0 +1,73 @@
+package chunker
+
+import (
+	"fmt"
+	"go/doc/comment"
+
+	"github.com/mdrideout/viberag/extractor"
+	"github.com/mdrideout/viberag/render"
+)
+
+// docChunks renders sym's header and doc comment as one chunk, unless that
+// exceeds opts.MaxTokens, in which case the doc comment is split across
+// continuation chunks at paragraph (go/doc/comment block) boundaries, with
+// the header kept intact on the first chunk.
+func docChunks(pkg *extractor.Package, opts Options, sym *extractor.Symbol) []Chunk {
+	path := symbolPath(pkg, sym)
+	kind := kindFor(sym)
+	head := render.SymbolHeader(pkg, sym, "##", opts.Repo)
+
+	if sym.Doc == "" {
+		return []Chunk{newChunk(opts, ID(path), kind, path, head)}
+	}
+
+	doc := pkg.ParseDoc(sym)
+	full := head + render.DocMarkdown(pkg, doc) + "\n\n"
+	if opts.MaxTokens <= 0 || opts.Estimator.Estimate(full) <= opts.MaxTokens {
+		return []Chunk{newChunk(opts, ID(path), kind, path, full)}
+	}
+
+	budget := opts.MaxTokens - opts.Estimator.Estimate(head)
+	groups := packBlocks(pkg, opts, doc.Content, budget)
+
+	chunks := make([]Chunk, 0, len(groups))
+	for i, group := range groups {
+		body := render.DocMarkdown(pkg, &comment.Doc{Content: group})
+
+		id := ID(path)
+		text := head + body + "\n\n"
+		if i > 0 {
+			id = ID(fmt.Sprintf("%s#%d", path, i+1))
+			text = fmt.Sprintf("%s (continued)\n\n%s\n\n", path, body)
+		}
+		chunks = append(chunks, newChunk(opts, id, kind, path, text))
+	}
+	return chunks
+}
+
+// packBlocks greedily groups blocks so that each group's rendered Markdown
+// stays within budget tokens, never splitting a single block (e.g. one
+// paragraph or code block) across two groups. A block that alone exceeds
+// budget gets its own group rather than being dropped.
+func packBlocks(pkg *extractor.Package, opts Options, blocks []comment.Block, budget int) [][]comment.Block {
+	if budget <= 0 {
+		budget = 1
+	}
+
+	var groups [][]comment.Block
+	var current []comment.Block
+	for _, block := range blocks {
+		candidate := append(append([]comment.Block{}, current...), block)
+		text := render.DocMarkdown(pkg, &comment.Doc{Content: candidate})
+		if len(current) > 0 && opts.Estimator.Estimate(text) > budget {
+			groups = append(groups, current)
+			current = []comment.Block{block}
+			continue
+		}
+		current = candidate
+	}
+	if len(current) > 0 {
+		groups = append(groups, current)
+	}
+	return groups
+}
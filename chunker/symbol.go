@@ -0,0 +1,153 @@
+package chunker
+
+import (
+	"fmt"
+
+	"github.com/mdrideout/viberag/extractor"
+	"github.com/mdrideout/viberag/render"
+)
+
+// symbolUnit is every chunk produced for a single symbol: its doc chunk
+// (possibly split across several, if it overflows MaxTokens) followed by
+// one chunk per usage example. chunks[0] is always the unit's head chunk.
+type symbolUnit struct {
+	sym    *extractor.Symbol
+	chunks []Chunk
+}
+
+// buildSymbolUnit renders sym's doc chunk(s) and example chunks, without
+// assigning Neighbors; callers link units together with linkUnits.
+func buildSymbolUnit(pkg *extractor.Package, opts Options, sym *extractor.Symbol) symbolUnit {
+	chunks := docChunks(pkg, opts, sym)
+	chunks = append(chunks, exampleChunks(pkg, opts, sym)...)
+	return symbolUnit{sym: sym, chunks: chunks}
+}
+
+// linkUnits sets Neighbors on every chunk across units: chunks within the
+// same unit (a symbol's own continuation and example chunks) plus the head
+// chunk of every other unit, so a retriever can expand either into a
+// symbol's own detail or sideways to its siblings.
+func linkUnits(units []symbolUnit) []Chunk {
+	heads := make([]ID, 0, len(units))
+	for _, u := range units {
+		if len(u.chunks) > 0 {
+			heads = append(heads, u.chunks[0].ID)
+		}
+	}
+
+	var all []Chunk
+	for i, u := range units {
+		for _, c := range u.chunks {
+			var neighbors []ID
+			for _, sibling := range u.chunks {
+				if sibling.ID != c.ID {
+					neighbors = append(neighbors, sibling.ID)
+				}
+			}
+			for j, head := range heads {
+				if j != i && head != c.ID {
+					neighbors = append(neighbors, head)
+				}
+			}
+			c.Neighbors = neighbors
+			all = append(all, c)
+		}
+	}
+	return all
+}
+
+// linkMesh fully connects every chunk in chunks to every other chunk,
+// in-place. Used where there's no finer per-unit structure to preserve
+// (e.g. the merged chunk of a ChunkPerType group alongside its examples).
+func linkMesh(chunks []Chunk) {
+	for i := range chunks {
+		var neighbors []ID
+		for j := range chunks {
+			if j != i {
+				neighbors = append(neighbors, chunks[j].ID)
+			}
+		}
+		chunks[i].Neighbors = neighbors
+	}
+}
+
+// chunkLooseSymbols groups syms (package-level funcs, consts, and vars that
+// aren't a type, method, or constructor) by the file they're declared in,
+// so each file's loose symbols are linked as siblings.
+func chunkLooseSymbols(pkg *extractor.Package, opts Options, syms []*extractor.Symbol) []Chunk {
+	byFile := map[string][]*extractor.Symbol{}
+	var fileOrder []string
+	for _, sym := range syms {
+		if _, ok := byFile[sym.File]; !ok {
+			fileOrder = append(fileOrder, sym.File)
+		}
+		byFile[sym.File] = append(byFile[sym.File], sym)
+	}
+
+	var all []Chunk
+	for _, file := range fileOrder {
+		members := byFile[file]
+		units := make([]symbolUnit, len(members))
+		for i, m := range members {
+			units[i] = buildSymbolUnit(pkg, opts, m)
+		}
+		all = append(all, linkUnits(units)...)
+	}
+	return all
+}
+
+func symbolPath(pkg *extractor.Package, sym *extractor.Symbol) string {
+	if sym.Kind == extractor.KindMethod {
+		return pkg.Name + "." + trimStar(sym.Receiver) + "." + sym.Name
+	}
+	return pkg.Name + "." + sym.Name
+}
+
+func trimStar(s string) string {
+	if len(s) > 0 && s[0] == '*' {
+		return s[1:]
+	}
+	return s
+}
+
+func kindFor(sym *extractor.Symbol) Kind {
+	if sym.Kind == extractor.KindType {
+		return KindType
+	}
+	return KindSymbol
+}
+
+func newChunk(opts Options, id ID, kind Kind, path, text string) Chunk {
+	return Chunk{
+		ID:            id,
+		Kind:          kind,
+		SymbolPath:    path,
+		Text:          text,
+		TokenEstimate: opts.Estimator.Estimate(text),
+	}
+}
+
+// exampleChunks renders one chunk per sym.Examples entry.
+func exampleChunks(pkg *extractor.Package, opts Options, sym *extractor.Symbol) []Chunk {
+	path := symbolPath(pkg, sym)
+
+	var chunks []Chunk
+	for _, ex := range sym.Examples {
+		text := fmt.Sprintf("```go\n%s\n```\n", ex.Code)
+		if ex.Output != "" {
+			text += fmt.Sprintf("\nOutput:\n\n```\n%s\n```\n", ex.Output)
+		}
+		id := ID(path + "#example:" + ex.Name)
+		chunks = append(chunks, newChunk(opts, id, KindExample, path, text))
+	}
+	return chunks
+}
+
+// typeMembers returns typeSym itself, followed by its constructors and
+// methods, in the order Render nests them.
+func typeMembers(typeSym *extractor.Symbol, g *render.Grouping) []*extractor.Symbol {
+	members := []*extractor.Symbol{typeSym}
+	members = append(members, g.ConstructorsOf(typeSym.Name)...)
+	members = append(members, g.MethodsOf(typeSym.Name)...)
+	return members
+}
@@ -0,0 +1,70 @@
+package extractor
+
+import (
+	"go/doc/comment"
+	"strings"
+)
+
+// ParseDoc parses symbol's godoc comment into a structured *comment.Doc,
+// resolving doc links such as [Greeter] and [Greeter.Greet] against the
+// other symbols in pkg so callers can tell a documentation link from a
+// plain bracketed word.
+func (pkg *Package) ParseDoc(symbol *Symbol) *comment.Doc {
+	parser := &comment.Parser{
+		LookupSym: pkg.lookupSym,
+	}
+	return parser.Parse(symbol.Doc)
+}
+
+// lookupSym implements comment.Parser.LookupSym against pkg's symbol table.
+func (pkg *Package) lookupSym(recv, name string) bool {
+	for _, sym := range pkg.Symbols {
+		if sym.Name != name {
+			continue
+		}
+		if recv == "" {
+			if sym.Kind != KindMethod {
+				return true
+			}
+			continue
+		}
+		if sym.Kind == KindMethod && strings.TrimPrefix(sym.Receiver, "*") == recv {
+			return true
+		}
+	}
+	return false
+}
+
+// detectDeprecation looks for the conventional "Deprecated:" paragraph
+// (see https://go.dev/wiki/Deprecated) in doc and, if found, reports it
+// along with the deprecation note: that paragraph and everything after it,
+// with the "Deprecated:" marker stripped.
+func (pkg *Package) detectDeprecation(doc string) (deprecated bool, note string) {
+	if doc == "" {
+		return false, ""
+	}
+
+	p := comment.Parser{LookupSym: pkg.lookupSym}
+	parsed := p.Parse(doc)
+	for i, block := range parsed.Content {
+		para, ok := block.(*comment.Paragraph)
+		if !ok || !startsWithDeprecated(para) {
+			continue
+		}
+
+		rest := &comment.Doc{Content: parsed.Content[i:]}
+		var pr comment.Printer
+		text := strings.TrimSpace(string(pr.Text(rest)))
+		text = strings.TrimPrefix(text, "Deprecated:")
+		return true, strings.TrimSpace(text)
+	}
+	return false, ""
+}
+
+func startsWithDeprecated(p *comment.Paragraph) bool {
+	if len(p.Text) == 0 {
+		return false
+	}
+	plain, ok := p.Text[0].(comment.Plain)
+	return ok && strings.HasPrefix(string(plain), "Deprecated:")
+}
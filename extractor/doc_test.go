@@ -0,0 +1,56 @@
+package extractor_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mdrideout/viberag/extractor"
+)
+
+const deprecatedSrc = `// Package dep is a test fixture.
+package dep
+
+// Widget is a thing.
+type Widget struct{}
+
+// OldWidget makes a Widget the old way.
+//
+// Deprecated: use [Widget] directly instead.
+func OldWidget() *Widget {
+	return &Widget{}
+}
+`
+
+func TestDeprecationDetection(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "dep.go"), []byte(deprecatedSrc), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	pkg, err := extractor.Extract(dir)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+
+	var oldWidget *extractor.Symbol
+	for _, sym := range pkg.Symbols {
+		if sym.Name == "OldWidget" {
+			oldWidget = sym
+		}
+	}
+	if oldWidget == nil {
+		t.Fatal("missing OldWidget symbol")
+	}
+	if !oldWidget.Deprecated {
+		t.Error("OldWidget.Deprecated = false, want true")
+	}
+	if oldWidget.DeprecationNote != "use Widget directly instead." {
+		t.Errorf("DeprecationNote = %q", oldWidget.DeprecationNote)
+	}
+
+	doc := pkg.ParseDoc(oldWidget)
+	if doc == nil || len(doc.Content) == 0 {
+		t.Fatal("ParseDoc returned empty Doc")
+	}
+}
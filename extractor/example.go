@@ -0,0 +1,138 @@
+package extractor
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/doc"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"strings"
+)
+
+// Example is a runnable usage example for a Symbol, taken from a
+// "func Example..." function in a _test.go file per the convention
+// documented at https://go.dev/blog/examples.
+type Example struct {
+	// Name is the example function's declared name, e.g.
+	// "ExampleGreeter_Greet".
+	Name string `json:"name"`
+	// Code is the example function's body, with the wrapping
+	// "func ExampleX() { ... }" stripped.
+	Code string `json:"code"`
+	// Output is the expected "// Output:" comment text, or "" if the
+	// example has none (and so is never executed for its output).
+	Output string `json:"output,omitempty"`
+	// Suffix is the part of the name after the symbol it exemplifies, e.g.
+	// "negative" for "ExampleAdd_negative". Empty for a plain example.
+	Suffix string `json:"suffix,omitempty"`
+}
+
+// extractExamples parses the _test.go files in pkg.Dir and attaches any
+// Example functions to the Symbol they exemplify, following the
+// Example/ExampleT/ExampleT_M/_suffix naming convention.
+func extractExamples(pkg *Package) error {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, pkg.Dir, nil, parser.ParseComments)
+	if err != nil {
+		return fmt.Errorf("extractor: parse %s for examples: %w", pkg.Dir, err)
+	}
+
+	var files []*ast.File
+	for _, p := range pkgs {
+		for _, f := range p.Files {
+			files = append(files, f)
+		}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	docPkg, err := doc.NewFromFiles(fset, files, pkg.Name)
+	if err != nil {
+		return fmt.Errorf("extractor: build doc package for %s: %w", pkg.Dir, err)
+	}
+
+	byName, methodsByRecv := indexSymbols(pkg)
+
+	for _, fn := range docPkg.Funcs {
+		attachExamples(fset, byName[fn.Name], fn.Examples)
+	}
+	for _, t := range docPkg.Types {
+		attachExamples(fset, byName[t.Name], t.Examples)
+		for _, f := range t.Funcs { // go/doc groups constructors under their type
+			attachExamples(fset, byName[f.Name], f.Examples)
+		}
+		for _, m := range t.Methods {
+			attachExamples(fset, methodsByRecv[t.Name][m.Name], m.Examples)
+		}
+	}
+	attachExamples(fset, nil, docPkg.Examples) // whole-package examples have no owning Symbol
+
+	return nil
+}
+
+func indexSymbols(pkg *Package) (byName map[string]*Symbol, methodsByRecv map[string]map[string]*Symbol) {
+	byName = map[string]*Symbol{}
+	methodsByRecv = map[string]map[string]*Symbol{}
+	for _, sym := range pkg.Symbols {
+		if sym.Kind != KindMethod {
+			byName[sym.Name] = sym
+			continue
+		}
+		recv := strings.TrimPrefix(sym.Receiver, "*")
+		if methodsByRecv[recv] == nil {
+			methodsByRecv[recv] = map[string]*Symbol{}
+		}
+		methodsByRecv[recv][sym.Name] = sym
+	}
+	return byName, methodsByRecv
+}
+
+func attachExamples(fset *token.FileSet, sym *Symbol, examples []*doc.Example) {
+	if sym == nil {
+		return
+	}
+	for _, ex := range examples {
+		name := "Example"
+		if ex.Name != "" {
+			name += ex.Name
+		}
+		sym.Examples = append(sym.Examples, Example{
+			Name:   name,
+			Code:   exampleCode(fset, ex.Code),
+			Output: strings.TrimRight(ex.Output, "\n"),
+			Suffix: ex.Suffix,
+		})
+	}
+}
+
+// exampleCode renders an Example's Code node (its function body, or
+// occasionally the whole function) as the statements a reader would copy,
+// without the enclosing "func ExampleX() { ... }".
+func exampleCode(fset *token.FileSet, node ast.Node) string {
+	body := node
+	if decl, ok := node.(*ast.FuncDecl); ok {
+		body = decl.Body
+	}
+
+	block, ok := body.(*ast.BlockStmt)
+	if !ok {
+		return nodeString(fset, node)
+	}
+
+	lines := make([]string, 0, len(block.List))
+	for _, stmt := range block.List {
+		lines = append(lines, nodeString(fset, stmt))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func nodeString(fset *token.FileSet, node ast.Node) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, node); err != nil {
+		return ""
+	}
+	return buf.String()
+}
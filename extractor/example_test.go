@@ -0,0 +1,60 @@
+package extractor_test
+
+import (
+	"testing"
+
+	"github.com/mdrideout/viberag/extractor"
+)
+
+func TestExtractExamples(t *testing.T) {
+	pkg, err := extractor.Extract("../test-fixtures/codebase")
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+
+	byName := map[string]*extractor.Symbol{}
+	for _, sym := range pkg.Symbols {
+		byName[sym.Name] = sym
+	}
+
+	greeter := byName["Greeter"]
+	if len(greeter.Examples) != 1 || greeter.Examples[0].Name != "ExampleGreeter" {
+		t.Errorf("Greeter.Examples = %+v, want one ExampleGreeter", greeter.Examples)
+	}
+
+	greet := byName["Greet"]
+	if len(greet.Examples) != 1 {
+		t.Fatalf("Greet.Examples = %+v, want one example", greet.Examples)
+	}
+	ex := greet.Examples[0]
+	if ex.Name != "ExampleGreeter_Greet" {
+		t.Errorf("Example.Name = %q, want %q", ex.Name, "ExampleGreeter_Greet")
+	}
+	if ex.Output != "Hello, Grace!" {
+		t.Errorf("Example.Output = %q, want %q", ex.Output, "Hello, Grace!")
+	}
+	if ex.Code == "" {
+		t.Error("Example.Code is empty")
+	}
+
+	add := byName["Add"]
+	if len(add.Examples) != 2 {
+		t.Fatalf("Add.Examples = %+v, want 2", add.Examples)
+	}
+
+	var plain, negative *extractor.Example
+	for i, ex := range add.Examples {
+		switch ex.Name {
+		case "ExampleAdd":
+			plain = &add.Examples[i]
+		case "ExampleAdd_negative":
+			negative = &add.Examples[i]
+		}
+	}
+	if plain == nil || plain.Output != "5" || plain.Suffix != "" {
+		t.Errorf("ExampleAdd = %+v, want Output 5 and empty Suffix", plain)
+	}
+	if negative == nil || negative.Output != "-5" || negative.Suffix != "negative" {
+		t.Errorf("ExampleAdd_negative = %+v, want Output -5 and Suffix %q", negative, "negative")
+	}
+}
@@ -0,0 +1,291 @@
+// Package extractor parses Go source files and extracts the top-level
+// symbols (types, funcs, methods, consts, vars) that make up a package's
+// public surface, for downstream rendering and chunking into a RAG index.
+package extractor
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Kind identifies the category of declaration a Symbol was extracted from.
+type Kind string
+
+const (
+	KindType   Kind = "type"
+	KindFunc   Kind = "func"
+	KindMethod Kind = "method"
+	KindConst  Kind = "const"
+	KindVar    Kind = "var"
+)
+
+// Symbol is a single top-level declaration extracted from a package.
+type Symbol struct {
+	Name string `json:"name"`
+	Kind Kind   `json:"kind"`
+
+	// Doc is the raw godoc comment attached to the declaration, with the
+	// comment markers stripped but paragraph breaks preserved.
+	Doc string `json:"doc,omitempty"`
+
+	// Signature is the source text of the declaration header (e.g.
+	// "func NewGreeter(name string) *Greeter"), without the body.
+	Signature string `json:"signature"`
+
+	// Receiver is the receiver type expression for methods, e.g. "*Greeter".
+	// It is empty for every other Kind.
+	Receiver string `json:"receiver,omitempty"`
+
+	// ReceiverName is the receiver variable name for methods, e.g. "g" for
+	// "func (g *Greeter) Greet()". Empty if the receiver is unnamed or for
+	// every other Kind.
+	ReceiverName string `json:"receiverName,omitempty"`
+
+	// Results holds the source text of each return type, in order, for
+	// KindFunc and KindMethod symbols. Used to detect constructors (a func
+	// whose first result is a package type).
+	Results []string `json:"results,omitempty"`
+
+	// Deprecated reports whether Doc contains a "Deprecated:" paragraph,
+	// per the convention described at https://go.dev/wiki/Deprecated.
+	Deprecated bool `json:"deprecated,omitempty"`
+	// DeprecationNote is the deprecation paragraph (and everything after
+	// it), with the "Deprecated:" marker removed. Empty unless Deprecated.
+	DeprecationNote string `json:"deprecationNote,omitempty"`
+
+	// Examples holds the runnable "func ExampleX" usage examples found in
+	// _test.go files that exemplify this symbol.
+	Examples []Example `json:"examples,omitempty"`
+
+	// Exported reports whether Name starts with an uppercase letter. Set
+	// regardless of ExtractOptions.IncludeUnexported, so callers that do
+	// include unexported symbols can still tell them apart. Surfaced as its
+	// own JSON field so a RAG prompt can be told to avoid suggesting
+	// unexported symbols to external callers while still using them to
+	// answer internal-developer questions.
+	Exported bool `json:"exported"`
+
+	File string `json:"file"`
+	Line int    `json:"line"`
+}
+
+// Package is the set of symbols extracted from a single directory of Go
+// source files.
+type Package struct {
+	Name string `json:"name"`
+	Doc  string `json:"doc,omitempty"`
+	Dir  string `json:"dir"`
+
+	Symbols []*Symbol `json:"symbols"`
+}
+
+// ExtractOptions configures Extract.
+type ExtractOptions struct {
+	// IncludeUnexported, if true, keeps unexported symbols in the returned
+	// Package instead of dropping them. Use this for indexing a codebase's
+	// internal API in addition to its public one; Symbol.Exported still
+	// tells the two apart in the result.
+	IncludeUnexported bool
+}
+
+// Extract parses the non-test .go files in dir and returns the package they
+// form, keeping only exported symbols. dir must contain exactly one package
+// (as the Go toolchain requires).
+func Extract(dir string) (*Package, error) {
+	return ExtractWithOptions(dir, ExtractOptions{})
+}
+
+// ExtractWithOptions is Extract with explicit options.
+func ExtractWithOptions(dir string, opts ExtractOptions) (*Package, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("extractor: parse %s: %w", dir, err)
+	}
+
+	var astPkg *ast.Package
+	for name, p := range pkgs {
+		if astPkg != nil {
+			return nil, fmt.Errorf("extractor: %s contains multiple packages (%s and %s)", dir, astPkg.Name, name)
+		}
+		astPkg = p
+	}
+	if astPkg == nil {
+		return nil, fmt.Errorf("extractor: %s contains no Go files", dir)
+	}
+
+	pkg := &Package{Name: astPkg.Name, Dir: dir}
+
+	// astPkg.Files is a map, so iterate filenames in sorted order: map
+	// iteration order is randomized per run, and both pkg.Doc (first file
+	// wins) and pkg.Symbols order depend on it otherwise.
+	filenames := make([]string, 0, len(astPkg.Files))
+	for name := range astPkg.Files {
+		filenames = append(filenames, name)
+	}
+	sort.Strings(filenames)
+
+	files := make([]*ast.File, len(filenames))
+	for i, name := range filenames {
+		files[i] = astPkg.Files[name]
+	}
+
+	for _, file := range files {
+		if pkg.Doc == "" && file.Doc != nil {
+			pkg.Doc = cleanDoc(file.Doc.Text())
+		}
+		for _, decl := range file.Decls {
+			syms, err := symbolsFromDecl(fset, decl)
+			if err != nil {
+				return nil, err
+			}
+			pkg.Symbols = append(pkg.Symbols, syms...)
+		}
+	}
+
+	for _, sym := range pkg.Symbols {
+		sym.Exported = ast.IsExported(sym.Name)
+		sym.Deprecated, sym.DeprecationNote = pkg.detectDeprecation(sym.Doc)
+	}
+
+	if !opts.IncludeUnexported {
+		kept := pkg.Symbols[:0]
+		for _, sym := range pkg.Symbols {
+			if sym.Exported {
+				kept = append(kept, sym)
+			}
+		}
+		pkg.Symbols = kept
+	}
+
+	if err := extractExamples(pkg); err != nil {
+		return nil, err
+	}
+
+	return pkg, nil
+}
+
+func symbolsFromDecl(fset *token.FileSet, decl ast.Decl) ([]*Symbol, error) {
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		return []*Symbol{symbolFromFunc(fset, d)}, nil
+	case *ast.GenDecl:
+		return symbolsFromGenDecl(fset, d), nil
+	default:
+		return nil, nil
+	}
+}
+
+func symbolFromFunc(fset *token.FileSet, d *ast.FuncDecl) *Symbol {
+	kind := KindFunc
+	receiver := ""
+	receiverName := ""
+	if d.Recv != nil && len(d.Recv.List) > 0 {
+		kind = KindMethod
+		receiver = exprString(d.Recv.List[0].Type)
+		if names := d.Recv.List[0].Names; len(names) > 0 {
+			receiverName = names[0].Name
+		}
+	}
+
+	sig := signatureString(d)
+
+	var results []string
+	if d.Type != nil && d.Type.Results != nil {
+		for _, field := range d.Type.Results.List {
+			typ := exprString(field.Type)
+			n := len(field.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				results = append(results, typ)
+			}
+		}
+	}
+
+	pos := fset.Position(d.Pos())
+	return &Symbol{
+		Name:         d.Name.Name,
+		Kind:         kind,
+		Doc:          cleanDoc(d.Doc.Text()),
+		Signature:    sig,
+		Receiver:     receiver,
+		ReceiverName: receiverName,
+		Results:      results,
+		File:         pos.Filename,
+		Line:         pos.Line,
+	}
+}
+
+func symbolsFromGenDecl(fset *token.FileSet, d *ast.GenDecl) []*Symbol {
+	var kind Kind
+	switch d.Tok {
+	case token.TYPE:
+		kind = KindType
+	case token.CONST:
+		kind = KindConst
+	case token.VAR:
+		kind = KindVar
+	default:
+		return nil
+	}
+
+	var syms []*Symbol
+	for _, spec := range d.Specs {
+		switch s := spec.(type) {
+		case *ast.TypeSpec:
+			doc := s.Doc
+			if doc == nil {
+				doc = d.Doc
+			}
+			pos := fset.Position(s.Pos())
+			syms = append(syms, &Symbol{
+				Name:      s.Name.Name,
+				Kind:      kind,
+				Doc:       cleanDoc(doc.Text()),
+				Signature: "type " + s.Name.Name + " " + exprString(s.Type),
+				File:      pos.Filename,
+				Line:      pos.Line,
+			})
+		case *ast.ValueSpec:
+			doc := s.Doc
+			if doc == nil {
+				doc = d.Doc
+			}
+			pos := fset.Position(s.Pos())
+			for _, name := range s.Names {
+				syms = append(syms, &Symbol{
+					Name:      name.Name,
+					Kind:      kind,
+					Doc:       cleanDoc(doc.Text()),
+					Signature: strings.TrimSpace(d.Tok.String() + " " + name.Name),
+					File:      pos.Filename,
+					Line:      pos.Line,
+				})
+			}
+		}
+	}
+	return syms
+}
+
+func cleanDoc(text string) string {
+	return strings.TrimRight(text, "\n")
+}
+
+// RelFile returns sym.File relative to dir, for building provenance links.
+func (s *Symbol) RelFile(dir string) string {
+	rel, err := filepath.Rel(dir, s.File)
+	if err != nil {
+		return s.File
+	}
+	return rel
+}
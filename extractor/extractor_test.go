@@ -0,0 +1,140 @@
+package extractor_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mdrideout/viberag/extractor"
+)
+
+func TestExtractSample(t *testing.T) {
+	pkg, err := extractor.Extract("../test-fixtures/codebase")
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+
+	if pkg.Name != "sample" {
+		t.Errorf("Name = %q, want %q", pkg.Name, "sample")
+	}
+
+	byName := map[string]*extractor.Symbol{}
+	for _, sym := range pkg.Symbols {
+		byName[sym.Name] = sym
+	}
+
+	greeter, ok := byName["Greeter"]
+	if !ok {
+		t.Fatal("missing Greeter symbol")
+	}
+	if greeter.Kind != extractor.KindType {
+		t.Errorf("Greeter.Kind = %q, want %q", greeter.Kind, extractor.KindType)
+	}
+
+	newGreeter, ok := byName["NewGreeter"]
+	if !ok {
+		t.Fatal("missing NewGreeter symbol")
+	}
+	if got, want := newGreeter.Results, []string{"*Greeter"}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("NewGreeter.Results = %v, want %v", got, want)
+	}
+
+	greet, ok := byName["Greet"]
+	if !ok {
+		t.Fatal("missing Greet symbol")
+	}
+	if greet.Kind != extractor.KindMethod {
+		t.Errorf("Greet.Kind = %q, want %q", greet.Kind, extractor.KindMethod)
+	}
+	if greet.Receiver != "*Greeter" {
+		t.Errorf("Greet.Receiver = %q, want %q", greet.Receiver, "*Greeter")
+	}
+	if !greet.Exported {
+		t.Errorf("Greet.Exported = false, want true")
+	}
+
+	if _, ok := byName["privateHelper"]; ok {
+		t.Error("Extract included unexported symbol privateHelper by default")
+	}
+}
+
+func TestExtractIncludeUnexported(t *testing.T) {
+	pkg, err := extractor.ExtractWithOptions("../test-fixtures/codebase", extractor.ExtractOptions{IncludeUnexported: true})
+	if err != nil {
+		t.Fatalf("ExtractWithOptions: %v", err)
+	}
+
+	byName := map[string]*extractor.Symbol{}
+	for _, sym := range pkg.Symbols {
+		byName[sym.Name] = sym
+	}
+
+	helper, ok := byName["privateHelper"]
+	if !ok {
+		t.Fatal("missing privateHelper symbol")
+	}
+	if helper.Exported {
+		t.Error("privateHelper.Exported = true, want false")
+	}
+
+	greeter, ok := byName["Greeter"]
+	if !ok {
+		t.Fatal("missing Greeter symbol")
+	}
+	if !greeter.Exported {
+		t.Error("Greeter.Exported = false, want true")
+	}
+}
+
+// TestExtractMultiFileOrderIsDeterministic guards against regressing to
+// map iteration order (astPkg.Files is a map, and Go randomizes map
+// iteration per run): symbols must always come out ordered by filename,
+// with the package doc coming from the alphabetically-first file.
+func TestExtractMultiFileOrderIsDeterministic(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"zzz.go": `package multi
+
+func Z() {}
+`,
+		"aaa.go": `// Package multi is a test fixture.
+package multi
+
+func A() {}
+`,
+		"mmm.go": `package multi
+
+func M() {}
+`,
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := 0; i < 10; i++ {
+		pkg, err := extractor.Extract(dir)
+		if err != nil {
+			t.Fatalf("Extract: %v", err)
+		}
+
+		if pkg.Doc != "Package multi is a test fixture." {
+			t.Fatalf("run %d: Doc = %q, want %q", i, pkg.Doc, "Package multi is a test fixture.")
+		}
+
+		var names []string
+		for _, sym := range pkg.Symbols {
+			names = append(names, sym.Name)
+		}
+		want := []string{"A", "M", "Z"}
+		if len(names) != len(want) {
+			t.Fatalf("run %d: Symbols = %v, want %v", i, names, want)
+		}
+		for j, name := range names {
+			if name != want[j] {
+				t.Fatalf("run %d: Symbols = %v, want %v", i, names, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package extractor
+
+import (
+	"bytes"
+	"go/ast"
+	"go/printer"
+	"go/token"
+)
+
+// exprString renders an ast.Expr back to source text, e.g. a receiver or
+// type expression.
+func exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), expr); err != nil {
+		return ""
+	}
+	return buf.String()
+}
+
+// signatureString renders a function declaration's header (name, type
+// parameters, params, and results) without its body.
+func signatureString(d *ast.FuncDecl) string {
+	headerOnly := &ast.FuncDecl{
+		Doc:  nil,
+		Recv: d.Recv,
+		Name: d.Name,
+		Type: d.Type,
+		Body: nil,
+	}
+
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), headerOnly); err != nil {
+		return d.Name.Name
+	}
+	return buf.String()
+}
@@ -0,0 +1,71 @@
+package loader
+
+import (
+	"go/types"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// Interface describes an interface type declared in a package: its method
+// set, and which of the package's own concrete types satisfy it. It answers
+// the common "what implements X?" question that symbol-level extraction
+// alone can't, since that requires full type information rather than AST
+// shape.
+type Interface struct {
+	Name    string
+	Methods []string
+
+	// ImplementedBy lists the concrete (non-interface) named types declared
+	// in the same package whose method set satisfies this interface, by
+	// value or by pointer.
+	ImplementedBy []string
+}
+
+// interfaces computes, via go/types, every named interface type declared in
+// pkg's scope and which of pkg's own concrete named types implement it.
+// Returns nil if pkg carries no type information.
+func interfaces(pkg *packages.Package) []Interface {
+	if pkg.Types == nil {
+		return nil
+	}
+	scope := pkg.Types.Scope()
+
+	var ifaceNames, concreteNames []string
+	for _, name := range scope.Names() {
+		tn, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok {
+			continue
+		}
+		if types.IsInterface(tn.Type()) {
+			ifaceNames = append(ifaceNames, name)
+		} else {
+			concreteNames = append(concreteNames, name)
+		}
+	}
+	sort.Strings(ifaceNames)
+	sort.Strings(concreteNames)
+
+	var result []Interface
+	for _, name := range ifaceNames {
+		iface := scope.Lookup(name).(*types.TypeName).Type().Underlying().(*types.Interface).Complete()
+
+		methods := make([]string, iface.NumMethods())
+		for i := 0; i < iface.NumMethods(); i++ {
+			m := iface.Method(i)
+			methods[i] = m.Name() + strings.TrimPrefix(m.Type().String(), "func")
+		}
+
+		var implementedBy []string
+		for _, cname := range concreteNames {
+			concrete := scope.Lookup(cname).(*types.TypeName).Type()
+			if types.Implements(concrete, iface) || types.Implements(types.NewPointer(concrete), iface) {
+				implementedBy = append(implementedBy, cname)
+			}
+		}
+
+		result = append(result, Interface{Name: name, Methods: methods, ImplementedBy: implementedBy})
+	}
+	return result
+}
@@ -0,0 +1,110 @@
+// Package loader resolves Go package patterns (including the "./..."
+// recursive form) to the packages they name, using the same module- and
+// build-tag-aware resolution as the go command, and extracts each one's
+// symbols for indexing.
+package loader
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/mdrideout/viberag/extractor"
+)
+
+// Package is a single resolved Go package: its identity in the module
+// graph, plus the symbols extracted from its directory.
+type Package struct {
+	// ImportPath is the package's import path, e.g.
+	// "github.com/mdrideout/viberag/extractor".
+	ImportPath string
+	// ModulePath is the import path of the module the package belongs to,
+	// e.g. "github.com/mdrideout/viberag". Empty if the package isn't part
+	// of a module.
+	ModulePath string
+	// Dir is the package's directory, relative to the module root (or to
+	// the current directory, if ModulePath is empty).
+	Dir string
+
+	// Extracted is the package's symbol table, as produced by
+	// extractor.Extract.
+	Extracted *extractor.Package
+
+	// Interfaces lists the package's interface types and, for each, which
+	// of the package's own concrete types implement it.
+	Interfaces []Interface
+}
+
+// Load resolves patterns (Go package patterns, including "./..." for
+// recursive descent) and returns one *Package per directory they match.
+// Patterns are resolved with golang.org/x/tools/go/packages, so module
+// boundaries, vendored dependencies, and build tags are honored the same
+// way the go command honors them.
+func Load(patterns ...string) ([]*Package, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedModule |
+			packages.NeedTypes | packages.NeedImports | packages.NeedDeps | packages.NeedSyntax,
+	}
+
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("loader: load %v: %w", patterns, err)
+	}
+
+	var errs []error
+	result := make([]*Package, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		for _, e := range pkg.Errors {
+			errs = append(errs, fmt.Errorf("loader: %s: %w", pkg.PkgPath, e))
+		}
+		if len(pkg.Errors) > 0 {
+			continue
+		}
+
+		loaded, err := load(pkg)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if loaded != nil {
+			result = append(result, loaded)
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
+
+func load(pkg *packages.Package) (*Package, error) {
+	if len(pkg.GoFiles) == 0 {
+		// Documentary pseudo-packages (unsafe, builtin) and packages with
+		// no buildable Go files carry nothing worth extracting.
+		return nil, nil
+	}
+	dir := filepath.Dir(pkg.GoFiles[0])
+
+	extracted, err := extractor.Extract(dir)
+	if err != nil {
+		return nil, fmt.Errorf("loader: extract %s: %w", pkg.PkgPath, err)
+	}
+
+	result := &Package{
+		ImportPath: pkg.PkgPath,
+		Dir:        dir,
+		Extracted:  extracted,
+		Interfaces: interfaces(pkg),
+	}
+	if pkg.Module != nil {
+		result.ModulePath = pkg.Module.Path
+		if rel, err := filepath.Rel(pkg.Module.Dir, dir); err == nil {
+			result.Dir = rel
+		}
+	} else if wd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(wd, dir); err == nil {
+			result.Dir = rel
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,69 @@
+package loader_test
+
+import (
+	"testing"
+
+	"github.com/mdrideout/viberag/loader"
+)
+
+func TestLoadSinglePackage(t *testing.T) {
+	pkgs, err := loader.Load("../test-fixtures/codebase")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(pkgs) != 1 {
+		t.Fatalf("Load returned %d packages, want 1", len(pkgs))
+	}
+
+	pkg := pkgs[0]
+	if pkg.ModulePath != "github.com/mdrideout/viberag" {
+		t.Errorf("ModulePath = %q", pkg.ModulePath)
+	}
+	if pkg.Dir != "test-fixtures/codebase" {
+		t.Errorf("Dir = %q, want %q", pkg.Dir, "test-fixtures/codebase")
+	}
+	if pkg.Extracted == nil || pkg.Extracted.Name != "sample" {
+		t.Errorf("Extracted package = %+v", pkg.Extracted)
+	}
+}
+
+func TestLoadImplements(t *testing.T) {
+	pkgs, err := loader.Load("../test-fixtures/codebase")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	pkg := pkgs[0]
+
+	var greetable *loader.Interface
+	for i, iface := range pkg.Interfaces {
+		if iface.Name == "Greetable" {
+			greetable = &pkg.Interfaces[i]
+		}
+	}
+	if greetable == nil {
+		t.Fatalf("no Greetable interface among %+v", pkg.Interfaces)
+	}
+	if len(greetable.Methods) != 1 || greetable.Methods[0] != "Greet() string" {
+		t.Errorf("Greetable.Methods = %v, want [\"Greet() string\"]", greetable.Methods)
+	}
+
+	var implementsGreeter bool
+	for _, name := range greetable.ImplementedBy {
+		if name == "Greeter" {
+			implementsGreeter = true
+		}
+	}
+	if !implementsGreeter {
+		t.Errorf("Greetable.ImplementedBy = %v, want it to include Greeter", greetable.ImplementedBy)
+	}
+}
+
+func TestLoadRecursive(t *testing.T) {
+	pkgs, err := loader.Load("../...")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(pkgs) < 2 {
+		t.Fatalf("Load(\"../...\") returned %d packages, want at least 2", len(pkgs))
+	}
+}
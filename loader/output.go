@@ -0,0 +1,60 @@
+package loader
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+// Templater writes one output file per Package, at a path built from a
+// text/template path pattern evaluated against the Package, e.g.
+// "{{.Dir}}/SYMBOLS.md" or "out/{{.ModulePath}}/{{.ImportPath}}.json".
+type Templater struct {
+	path *template.Template
+}
+
+// NewTemplater parses pathPattern as a text/template executed against a
+// *Package to produce each package's output file path.
+func NewTemplater(pathPattern string) (*Templater, error) {
+	tmpl, err := template.New("path").Parse(pathPattern)
+	if err != nil {
+		return nil, fmt.Errorf("loader: parse path template %q: %w", pathPattern, err)
+	}
+	return &Templater{path: tmpl}, nil
+}
+
+// WriteAll renders each package with render and writes the result to the
+// path produced by the path template, creating parent directories as
+// needed.
+func (t *Templater) WriteAll(pkgs []*Package, render func(*Package) ([]byte, error)) error {
+	for _, pkg := range pkgs {
+		path, err := t.Path(pkg)
+		if err != nil {
+			return err
+		}
+
+		data, err := render(pkg)
+		if err != nil {
+			return fmt.Errorf("loader: render %s: %w", pkg.ImportPath, err)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return fmt.Errorf("loader: create directory for %s: %w", path, err)
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			return fmt.Errorf("loader: write %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// Path evaluates the path template against pkg.
+func (t *Templater) Path(pkg *Package) (string, error) {
+	var buf bytes.Buffer
+	if err := t.path.Execute(&buf, pkg); err != nil {
+		return "", fmt.Errorf("loader: execute path template for %s: %w", pkg.ImportPath, err)
+	}
+	return buf.String(), nil
+}
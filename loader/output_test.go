@@ -0,0 +1,38 @@
+package loader_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mdrideout/viberag/loader"
+)
+
+func TestTemplaterWriteAll(t *testing.T) {
+	pkgs, err := loader.Load("../test-fixtures/codebase")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	dir := t.TempDir()
+	tmpl, err := loader.NewTemplater(filepath.Join(dir, "{{.Dir}}/SYMBOLS.md"))
+	if err != nil {
+		t.Fatalf("NewTemplater: %v", err)
+	}
+
+	err = tmpl.WriteAll(pkgs, func(pkg *loader.Package) ([]byte, error) {
+		return []byte("# " + pkg.Extracted.Name + "\n"), nil
+	})
+	if err != nil {
+		t.Fatalf("WriteAll: %v", err)
+	}
+
+	want := filepath.Join(dir, "test-fixtures/codebase/SYMBOLS.md")
+	data, err := os.ReadFile(want)
+	if err != nil {
+		t.Fatalf("ReadFile(%s): %v", want, err)
+	}
+	if string(data) != "# sample\n" {
+		t.Errorf("content = %q", data)
+	}
+}
@@ -0,0 +1,119 @@
+package render
+
+import (
+	"go/doc/comment"
+	"path"
+	"strings"
+
+	"github.com/mdrideout/viberag/extractor"
+)
+
+// DocMarkdown renders a symbol's parsed doc comment to Markdown, rewriting
+// its [Name] / [Recv.Name] doc links into anchors within the rendered
+// package document (e.g. "#type-greeter") instead of go/doc/comment's
+// default "#Name" anchors, so they resolve against Render's own headings.
+func DocMarkdown(pkg *extractor.Package, doc *comment.Doc) string {
+	printer := &comment.Printer{
+		DocLinkURL: func(link *comment.DocLink) string {
+			return docLinkAnchor(pkg, link)
+		},
+	}
+	return string(printer.Markdown(doc))
+}
+
+// DocText renders a symbol's parsed doc comment to plain text suitable for
+// an embedding model: doc links are resolved to "pkgname.Symbol" (or
+// "pkgname.Recv.Method") form rather than go/doc/comment's default of
+// simply dropping the brackets.
+func DocText(pkgName string, doc *comment.Doc) string {
+	var b strings.Builder
+	for i, block := range doc.Content {
+		if i > 0 {
+			b.WriteString("\n\n")
+		}
+		writeTextBlock(&b, pkgName, block)
+	}
+	return b.String()
+}
+
+func writeTextBlock(b *strings.Builder, pkgName string, block comment.Block) {
+	switch x := block.(type) {
+	case *comment.Paragraph:
+		writeText(b, pkgName, x.Text)
+	case *comment.Heading:
+		writeText(b, pkgName, x.Text)
+	case *comment.Code:
+		b.WriteString(strings.TrimRight(x.Text, "\n"))
+	case *comment.List:
+		for i, item := range x.Items {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+			b.WriteString("- ")
+			for j, para := range item.Content {
+				if j > 0 {
+					b.WriteString(" ")
+				}
+				if p, ok := para.(*comment.Paragraph); ok {
+					writeText(b, pkgName, p.Text)
+				}
+			}
+		}
+	}
+}
+
+func writeText(b *strings.Builder, pkgName string, texts []comment.Text) {
+	for _, t := range texts {
+		switch x := t.(type) {
+		case comment.Plain:
+			b.WriteString(string(x))
+		case comment.Italic:
+			b.WriteString(string(x))
+		case *comment.Link:
+			writeText(b, pkgName, x.Text)
+		case *comment.DocLink:
+			b.WriteString(docLinkText(pkgName, x))
+		}
+	}
+}
+
+// docLinkText renders link in "pkgname.Symbol" / "pkgname.Recv.Method" form.
+func docLinkText(pkgName string, link *comment.DocLink) string {
+	pkg := pkgName
+	if link.ImportPath != "" {
+		pkg = path.Base(link.ImportPath)
+	}
+
+	name := link.Name
+	if link.Recv != "" {
+		name = link.Recv + "." + link.Name
+	}
+	if name == "" {
+		return pkg
+	}
+	return pkg + "." + name
+}
+
+// docLinkAnchor returns the "#"-prefixed anchor Render would have generated
+// for the symbol link identifies, or "" if link points outside pkg (an
+// external import, or a symbol Render doesn't know about).
+func docLinkAnchor(pkg *extractor.Package, link *comment.DocLink) string {
+	if link.ImportPath != "" {
+		return ""
+	}
+
+	for _, sym := range pkg.Symbols {
+		if sym.Name != link.Name {
+			continue
+		}
+		if link.Recv == "" {
+			if sym.Kind == extractor.KindMethod {
+				continue
+			}
+		} else if sym.Kind != extractor.KindMethod || strings.TrimPrefix(sym.Receiver, "*") != link.Recv {
+			continue
+		}
+		return "#" + anchor(headingText(sym))
+	}
+	return ""
+}
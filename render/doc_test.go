@@ -0,0 +1,82 @@
+package render_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mdrideout/viberag/extractor"
+	"github.com/mdrideout/viberag/render"
+)
+
+const linkedSrc = `// Package dep is a test fixture.
+package dep
+
+// Widget is a thing.
+type Widget struct{}
+
+// NewWidget builds a [Widget].
+func NewWidget() *Widget {
+	return &Widget{}
+}
+
+// Use reads about [Widget.Reset] before calling this.
+func Use() {}
+
+// Reset clears the widget.
+func (w *Widget) Reset() {}
+`
+
+func TestDocLinkRewriting(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "dep.go"), []byte(linkedSrc), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	pkg, err := extractor.Extract(dir)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+
+	out, err := render.Render(pkg, render.RepositoryInfo{})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	for _, want := range []string{
+		"[Widget](#type-widget)",
+		"[Widget.Reset](#func-w-widget-reset)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("rendered output missing %q\n---\n%s", want, out)
+		}
+	}
+}
+
+func TestDocTextResolvesLinksToPkgSymbolForm(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "dep.go"), []byte(linkedSrc), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	pkg, err := extractor.Extract(dir)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+
+	var newWidget *extractor.Symbol
+	for _, sym := range pkg.Symbols {
+		if sym.Name == "NewWidget" {
+			newWidget = sym
+		}
+	}
+	if newWidget == nil {
+		t.Fatal("missing NewWidget symbol")
+	}
+
+	text := render.DocText(pkg.Name, pkg.ParseDoc(newWidget))
+	if want := "dep.Widget"; !strings.Contains(text, want) {
+		t.Errorf("DocText = %q, want it to contain %q", text, want)
+	}
+}
@@ -0,0 +1,248 @@
+// Package render turns an extracted package into a retrieval-friendly
+// Markdown document: one heading per top-level symbol, with the symbol's
+// signature, doc comment, and cross-links back to its source.
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mdrideout/viberag/extractor"
+)
+
+// RepositoryInfo locates the source repository a package was extracted
+// from, so rendered symbols can link to their definition on GitHub/GitLab.
+type RepositoryInfo struct {
+	// URL is the repository's web URL, e.g. "https://github.com/org/repo".
+	URL string
+	// DefaultBranch is the branch source links should point at, e.g. "main".
+	DefaultBranch string
+	// Path is the package's directory relative to the repository root.
+	Path string
+}
+
+// sourceURL returns the permalink for a symbol defined at line in the
+// package's source, or "" if repo has no URL configured.
+func (repo RepositoryInfo) sourceURL(relFile string, line int) string {
+	if repo.URL == "" {
+		return ""
+	}
+	branch := repo.DefaultBranch
+	if branch == "" {
+		branch = "main"
+	}
+	file := relFile
+	if repo.Path != "" {
+		file = repo.Path + "/" + relFile
+	}
+	return fmt.Sprintf("%s/blob/%s/%s#L%d", strings.TrimRight(repo.URL, "/"), branch, file, line)
+}
+
+// Render walks pkg and emits one Markdown chunk per top-level symbol: types
+// as "## type Name" headings, their constructors and methods nested as
+// "### func" and "#### func (recv) Name" respectively, and free-standing
+// funcs, consts, and vars as "### " headings in declaration order.
+func Render(pkg *extractor.Package, repo RepositoryInfo) (string, error) {
+	if pkg == nil {
+		return "", fmt.Errorf("render: nil package")
+	}
+
+	g := NewGrouping(pkg)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Package %s\n\n", pkg.Name)
+	if pkg.Doc != "" {
+		fmt.Fprintf(&b, "%s\n\n", pkg.Doc)
+	}
+
+	for _, sym := range pkg.Symbols {
+		if sym.Kind != extractor.KindType {
+			continue
+		}
+		renderType(&b, sym, g, pkg, repo)
+	}
+
+	for _, sym := range g.LooseSymbols() {
+		renderSymbol(&b, sym, "###", pkg, repo)
+	}
+
+	return b.String(), nil
+}
+
+// RenderSymbol renders sym as a standalone Markdown chunk: heading, source
+// link, signature, and doc comment — the same content Render nests inline,
+// without the surrounding package document. Used by chunker to build one
+// chunk's text per symbol.
+func RenderSymbol(pkg *extractor.Package, sym *extractor.Symbol, repo RepositoryInfo) string {
+	var b strings.Builder
+	renderSymbol(&b, sym, "##", pkg, repo)
+	return b.String()
+}
+
+func renderType(b *strings.Builder, typeSym *extractor.Symbol, g *Grouping, pkg *extractor.Package, repo RepositoryInfo) {
+	renderSymbol(b, typeSym, "##", pkg, repo)
+
+	for _, ctor := range g.ConstructorsOf(typeSym.Name) {
+		renderSymbol(b, ctor, "###", pkg, repo)
+		fmt.Fprintf(b, "Constructs [%s](#%s).\n\n", typeSym.Name, anchor(headingText(typeSym)))
+	}
+
+	for _, m := range g.MethodsOf(typeSym.Name) {
+		renderSymbol(b, m, "####", pkg, repo)
+		fmt.Fprintf(b, "Method of [%s](#%s).\n\n", typeSym.Name, anchor(headingText(typeSym)))
+	}
+}
+
+func renderSymbol(b *strings.Builder, sym *extractor.Symbol, level string, pkg *extractor.Package, repo RepositoryInfo) {
+	b.WriteString(SymbolHeader(pkg, sym, level, repo))
+
+	if sym.Doc != "" {
+		fmt.Fprintf(b, "%s\n\n", DocMarkdown(pkg, pkg.ParseDoc(sym)))
+	}
+}
+
+// SymbolHeader renders sym's heading, source link, and fenced signature —
+// everything Render emits for a symbol except its doc comment. Exported so
+// callers that need to budget or split a symbol's doc comment separately
+// (such as chunker) can still share the rest of the rendering.
+func SymbolHeader(pkg *extractor.Package, sym *extractor.Symbol, level string, repo RepositoryInfo) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s\n\n", level, headingText(sym))
+
+	if url := repo.sourceURL(sym.RelFile(pkg.Dir), sym.Line); url != "" {
+		fmt.Fprintf(&b, "[source](%s)\n\n", url)
+	}
+
+	fmt.Fprintf(&b, "```go\n%s\n```\n\n", sym.Signature)
+	return b.String()
+}
+
+func headingText(sym *extractor.Symbol) string {
+	var text string
+	switch sym.Kind {
+	case extractor.KindType:
+		text = "type " + sym.Name
+	case extractor.KindMethod:
+		text = fmt.Sprintf("func (%s) %s", receiverVar(sym), sym.Name)
+	default:
+		text = string(sym.Kind) + " " + sym.Name
+	}
+	if !sym.Exported {
+		text += " (unexported)"
+	}
+	return text
+}
+
+// receiverVar renders sym's receiver as "g *Greeter", the same form the
+// original method declaration used, falling back to the bare type
+// expression if the receiver is unnamed.
+func receiverVar(sym *extractor.Symbol) string {
+	if sym.ReceiverName == "" {
+		return sym.Receiver
+	}
+	return sym.ReceiverName + " " + sym.Receiver
+}
+
+// anchor mirrors GitHub's Markdown heading-to-anchor slug algorithm closely
+// enough for our own internal cross-links (lowercase, spaces to hyphens).
+func anchor(heading string) string {
+	s := strings.ToLower(heading)
+	s = strings.NewReplacer("(", "", ")", "", "*", "", ".", "").Replace(s)
+	s = strings.Join(strings.Fields(s), "-")
+	return s
+}
+
+// Grouping indexes a package's methods and constructors by the type they
+// belong to, so Render can nest them under their owning "## type" heading.
+// It is exported so other packages (such as chunker) can reuse the same
+// method/constructor detection Render uses.
+type Grouping struct {
+	pkg               *extractor.Package
+	methodsOf         map[string][]*extractor.Symbol
+	constructorsOf    map[string][]*extractor.Symbol
+	constructorTarget map[string]string // func name -> type name it constructs
+	typeNames         map[string]bool
+}
+
+// NewGrouping indexes pkg's methods and constructors by owning type.
+func NewGrouping(pkg *extractor.Package) *Grouping {
+	g := &Grouping{
+		pkg:               pkg,
+		methodsOf:         map[string][]*extractor.Symbol{},
+		constructorsOf:    map[string][]*extractor.Symbol{},
+		constructorTarget: map[string]string{},
+		typeNames:         map[string]bool{},
+	}
+
+	for _, sym := range pkg.Symbols {
+		if sym.Kind == extractor.KindType {
+			g.typeNames[sym.Name] = true
+		}
+	}
+
+	for _, sym := range pkg.Symbols {
+		switch sym.Kind {
+		case extractor.KindMethod:
+			typeName := strings.TrimPrefix(sym.Receiver, "*")
+			g.methodsOf[typeName] = append(g.methodsOf[typeName], sym)
+		case extractor.KindFunc:
+			if target := constructedType(sym, g.typeNames); target != "" {
+				g.constructorsOf[target] = append(g.constructorsOf[target], sym)
+				g.constructorTarget[sym.Name] = target
+			}
+		}
+	}
+
+	for _, syms := range g.methodsOf {
+		sort.Slice(syms, func(i, j int) bool { return syms[i].Name < syms[j].Name })
+	}
+	for _, syms := range g.constructorsOf {
+		sort.Slice(syms, func(i, j int) bool { return syms[i].Name < syms[j].Name })
+	}
+
+	return g
+}
+
+// MethodsOf returns typeName's methods, sorted by name.
+func (g *Grouping) MethodsOf(typeName string) []*extractor.Symbol {
+	return g.methodsOf[typeName]
+}
+
+// ConstructorsOf returns the functions that construct typeName, sorted by
+// name. See constructedType for the detection rule.
+func (g *Grouping) ConstructorsOf(typeName string) []*extractor.Symbol {
+	return g.constructorsOf[typeName]
+}
+
+// LooseSymbols returns the package-level funcs, consts, and vars that are
+// neither methods nor constructors already nested under a type section.
+func (g *Grouping) LooseSymbols() []*extractor.Symbol {
+	var out []*extractor.Symbol
+	for _, sym := range g.pkg.Symbols {
+		switch sym.Kind {
+		case extractor.KindType, extractor.KindMethod:
+			continue
+		case extractor.KindFunc:
+			if _, isCtor := g.constructorTarget[sym.Name]; isCtor {
+				continue
+			}
+		}
+		out = append(out, sym)
+	}
+	return out
+}
+
+// constructedType reports the package type that fn constructs: its first
+// return type, stripped of a leading "*", if that names a type in the
+// package (e.g. NewGreeter() *Greeter -> "Greeter").
+func constructedType(fn *extractor.Symbol, typeNames map[string]bool) string {
+	if len(fn.Results) == 0 {
+		return ""
+	}
+	candidate := strings.TrimPrefix(fn.Results[0], "*")
+	if typeNames[candidate] {
+		return candidate
+	}
+	return ""
+}
@@ -0,0 +1,100 @@
+package render_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mdrideout/viberag/extractor"
+	"github.com/mdrideout/viberag/render"
+)
+
+func TestRenderHeadingsAndBacklinks(t *testing.T) {
+	pkg, err := extractor.Extract("../test-fixtures/codebase")
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+
+	out, err := render.Render(pkg, render.RepositoryInfo{
+		URL:           "https://github.com/mdrideout/viberag",
+		DefaultBranch: "main",
+		Path:          "test-fixtures/codebase",
+	})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	for _, want := range []string{
+		"## type Greeter",
+		"### func NewGreeter",
+		"#### func (g *Greeter) Greet",
+		"Constructs [Greeter]",
+		"Method of [Greeter]",
+		"https://github.com/mdrideout/viberag/blob/main/test-fixtures/codebase/sample.go#L8",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("rendered output missing %q\n---\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderUnexportedBadge(t *testing.T) {
+	pkg, err := extractor.ExtractWithOptions("../test-fixtures/codebase", extractor.ExtractOptions{IncludeUnexported: true})
+	if err != nil {
+		t.Fatalf("ExtractWithOptions: %v", err)
+	}
+
+	out, err := render.Render(pkg, render.RepositoryInfo{})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	if !strings.Contains(out, "func privateHelper (unexported)") {
+		t.Errorf("rendered output missing unexported badge for privateHelper\n---\n%s", out)
+	}
+	if strings.Contains(out, "type Greeter (unexported)") {
+		t.Errorf("exported symbol Greeter incorrectly marked unexported\n---\n%s", out)
+	}
+}
+
+// TestRenderUnexportedTypeBacklinkAnchors guards against the backlink
+// anchor being built from the bare type name (as it once was) instead of
+// the actual "(unexported)"-suffixed heading, which would produce a dead
+// link for any unexported type that has a constructor or method.
+func TestRenderUnexportedTypeBacklinkAnchors(t *testing.T) {
+	dir := t.TempDir()
+	src := `package dep
+
+type thing struct{}
+
+func newThing() *thing {
+	return &thing{}
+}
+
+func (t *thing) reset() {}
+`
+	if err := os.WriteFile(filepath.Join(dir, "dep.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	pkg, err := extractor.ExtractWithOptions(dir, extractor.ExtractOptions{IncludeUnexported: true})
+	if err != nil {
+		t.Fatalf("ExtractWithOptions: %v", err)
+	}
+
+	out, err := render.Render(pkg, render.RepositoryInfo{})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	for _, want := range []string{
+		"## type thing (unexported)",
+		"Constructs [thing](#type-thing-unexported)",
+		"Method of [thing](#type-thing-unexported)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("rendered output missing %q\n---\n%s", want, out)
+		}
+	}
+}
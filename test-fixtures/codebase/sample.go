@@ -14,6 +14,11 @@ type unexportedStruct struct {
 	value int
 }
 
+// Greetable is satisfied by anything that can produce a greeting.
+type Greetable interface {
+	Greet() string
+}
+
 // NewGreeter creates a new Greeter instance.
 // This is a constructor function.
 func NewGreeter(name string) *Greeter {
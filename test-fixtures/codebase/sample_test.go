@@ -0,0 +1,25 @@
+package sample
+
+import "fmt"
+
+func ExampleGreeter() {
+	g := NewGreeter("Ada")
+	fmt.Println(g.Greet())
+	// Output: Hello, Ada!
+}
+
+func ExampleGreeter_Greet() {
+	g := NewGreeter("Grace")
+	fmt.Println(g.Greet())
+	// Output: Hello, Grace!
+}
+
+func ExampleAdd() {
+	fmt.Println(Add(2, 3))
+	// Output: 5
+}
+
+func ExampleAdd_negative() {
+	fmt.Println(Add(-2, -3))
+	// Output: -5
+}